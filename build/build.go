@@ -0,0 +1,86 @@
+// Package build compiles a submission source file with the toolchain
+// matched to its file extension, streaming the compiler's output like
+// the runner package does for submissions, and caches the resulting
+// binary so re-running the same solution skips recompilation.
+package build
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// CompileError is returned by Build when the toolchain rejects the
+// source; Stderr holds the compiler's diagnostics so the caller can
+// report a CE verdict with the reason attached.
+type CompileError struct {
+	Toolchain string
+	Stderr    []byte
+}
+
+func (e *CompileError) Error() string {
+	return fmt.Sprintf("%s: compile failed:\n%s", e.Toolchain, e.Stderr)
+}
+
+// Build compiles src with the toolchain for its extension and returns
+// the path to a runnable binary, reusing cacheDir/<hash> from a
+// previous build when the source and toolchain version are unchanged.
+// It returns a *CompileError if the toolchain rejects the source.
+func Build(ctx context.Context, src, cacheDir string) (string, error) {
+	tc, ok := toolchainFor(filepath.Ext(src))
+	if !ok {
+		return "", fmt.Errorf("no toolchain registered for %s", filepath.Ext(src))
+	}
+
+	version, err := tc.version(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", tc.name, err)
+	}
+
+	key, err := cacheKey(src, tc.name, version)
+	if err != nil {
+		return "", err
+	}
+	binPath := filepath.Join(cacheDir, key+tc.binSuffix)
+	if _, err := os.Stat(binPath); err == nil {
+		return binPath, nil
+	}
+
+	if err := os.MkdirAll(cacheDir, 0o755); err != nil {
+		return "", err
+	}
+
+	workDir, err := os.MkdirTemp("", "judge-build-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(workDir)
+
+	stderr, err := tc.build(ctx, src, workDir, binPath)
+	if err != nil {
+		os.Remove(binPath)
+		return "", &CompileError{Toolchain: tc.name, Stderr: stderr}
+	}
+	return binPath, nil
+}
+
+// cacheKey is sha256(source bytes) + toolchain name + toolchain version,
+// so a compiler upgrade or a source edit both invalidate the cache.
+func cacheKey(src, toolchainName, version string) (string, error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	fmt.Fprintf(h, "|%s|%s", toolchainName, version)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
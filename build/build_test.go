@@ -0,0 +1,98 @@
+package build
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func requireTool(t *testing.T, name string) {
+	t.Helper()
+	if _, err := exec.LookPath(name); err != nil {
+		t.Skipf("%s not installed", name)
+	}
+}
+
+func TestBuildAndRunC(t *testing.T) {
+	requireTool(t, "gcc")
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "main.c")
+	prog := `#include <stdio.h>
+int main(void) { printf("hello\n"); return 0; }
+`
+	if err := os.WriteFile(src, []byte(prog), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	binPath, err := Build(context.Background(), src, filepath.Join(dir, "cache"))
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	out, err := exec.Command(binPath).CombinedOutput()
+	if err != nil {
+		t.Fatalf("running built binary: %v\n%s", err, out)
+	}
+	if got := strings.TrimSpace(string(out)); got != "hello" {
+		t.Errorf("output = %q, want %q", got, "hello")
+	}
+}
+
+// TestBuildAndRunJava builds and then actually runs a trivial Java
+// submission. A prior version of buildJava baked the ephemeral build
+// workDir into the cached wrapper script's -cp argument, so the
+// wrapper failed with "could not find or load main class" the moment
+// Build's deferred os.RemoveAll(workDir) fired — a bug a build-only
+// test without this run step would have missed.
+func TestBuildAndRunJava(t *testing.T) {
+	requireTool(t, "javac")
+	requireTool(t, "java")
+
+	dir := t.TempDir()
+	src := filepath.Join(dir, "Main.java")
+	prog := `public class Main {
+    public static void main(String[] args) {
+        System.out.println("hello");
+    }
+}
+`
+	if err := os.WriteFile(src, []byte(prog), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cacheDir := filepath.Join(dir, "cache")
+	binPath, err := Build(context.Background(), src, cacheDir)
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	out, err := exec.Command(binPath).CombinedOutput()
+	if err != nil {
+		t.Fatalf("running built binary: %v\n%s", err, out)
+	}
+	if got := strings.TrimSpace(string(out)); got != "hello" {
+		t.Errorf("output = %q, want %q", got, "hello")
+	}
+
+	// The cached binary must still run after a second Build call,
+	// which proves the wrapper's classpath survived workDir cleanup
+	// rather than pointing at a directory the first call deleted.
+	binPath2, err := Build(context.Background(), src, cacheDir)
+	if err != nil {
+		t.Fatalf("Build (cached): %v", err)
+	}
+	if binPath2 != binPath {
+		t.Fatalf("cached Build returned %q, want %q", binPath2, binPath)
+	}
+	out, err = exec.Command(binPath2).CombinedOutput()
+	if err != nil {
+		t.Fatalf("running cached binary: %v\n%s", err, out)
+	}
+	if got := strings.TrimSpace(string(out)); got != "hello" {
+		t.Errorf("cached output = %q, want %q", got, "hello")
+	}
+}
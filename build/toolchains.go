@@ -0,0 +1,141 @@
+package build
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// toolchain knows how to turn a submission source file into a runnable
+// binary at outBin, and how to fingerprint its own version for the
+// build cache key. outBin is the final, persistent cache location, not
+// a temporary path: a toolchain that needs to leave other artifacts
+// behind (Java's .class files) must place them next to outBin rather
+// than in workDir, which is removed as soon as build returns.
+type toolchain struct {
+	name      string
+	binSuffix string
+	version   func(ctx context.Context) (string, error)
+	build     func(ctx context.Context, src, workDir, outBin string) (stderr []byte, err error)
+}
+
+func toolchainFor(ext string) (toolchain, bool) {
+	tc, ok := toolchains[ext]
+	return tc, ok
+}
+
+// Recognized reports whether Build has a toolchain registered for ext
+// (as returned by filepath.Ext, including the leading dot).
+func Recognized(ext string) bool {
+	_, ok := toolchains[ext]
+	return ok
+}
+
+var toolchains = map[string]toolchain{
+	".cpp": compiledToolchain("g++", "g++", "-O2", "-std=c++20"),
+	".cc":  compiledToolchain("g++", "g++", "-O2", "-std=c++20"),
+	".c":   compiledToolchain("gcc", "gcc", "-O2"),
+	".go":  goToolchain(),
+	".rs":  compiledToolchain("rustc", "rustc", "-O"),
+	".py":  interpretedToolchain("python3", "python3"),
+	".js":  interpretedToolchain("node", "node"),
+	".java": {
+		name:      "javac",
+		binSuffix: "",
+		version:   commandVersion("javac", "-version"),
+		build:     buildJava,
+	},
+}
+
+// compiledToolchain builds a toolchain entry for a compiler invoked as
+// `compiler flags... -o outBin src`.
+func compiledToolchain(name, compiler string, flags ...string) toolchain {
+	return toolchain{
+		name:      name,
+		binSuffix: "",
+		version:   commandVersion(compiler, "--version"),
+		build: func(ctx context.Context, src, workDir, outBin string) ([]byte, error) {
+			args := append(append([]string{}, flags...), "-o", outBin, src)
+			return runCompiler(ctx, compiler, args, workDir)
+		},
+	}
+}
+
+// goToolchain builds the toolchain entry for Go: like compiledToolchain
+// but probing "go version" rather than "go --version", since go is a
+// subcommand-style CLI and rejects that flag.
+func goToolchain() toolchain {
+	tc := compiledToolchain("go", "go", "build")
+	tc.version = commandVersion("go", "version")
+	return tc
+}
+
+// interpretedToolchain builds a toolchain entry for a language that
+// runs via an interpreter rather than compiling to a binary: "building"
+// just writes a shebang wrapper script so judge run can exec the result
+// uniformly, regardless of language.
+func interpretedToolchain(name, interpreter string) toolchain {
+	return toolchain{
+		name:      name,
+		binSuffix: ".sh",
+		version:   commandVersion(interpreter, "--version"),
+		build: func(ctx context.Context, src, workDir, outBin string) ([]byte, error) {
+			return nil, writeShebangWrapper(outBin, interpreter, src)
+		},
+	}
+}
+
+// buildJava compiles src's .class files into classDir(outBin), a
+// directory that lives alongside outBin in the persistent cache, since
+// workDir is deleted the moment Build returns and a wrapper script
+// baking in a now-deleted classpath would fail on its very first run.
+func buildJava(ctx context.Context, src, workDir, outBin string) ([]byte, error) {
+	classDir := classDirFor(outBin)
+	if err := os.MkdirAll(classDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	stderr, err := runCompiler(ctx, "javac", []string{"-d", classDir, src}, workDir)
+	if err != nil {
+		os.RemoveAll(classDir)
+		return stderr, err
+	}
+	className, err := mainClassName(classDir)
+	if err != nil {
+		os.RemoveAll(classDir)
+		return nil, err
+	}
+	return nil, writeShebangWrapper(outBin, "java", "-cp", classDir, className)
+}
+
+// classDirFor returns the persistent directory Java's compiled classes
+// for the cached binary at binPath are kept in.
+func classDirFor(binPath string) string {
+	return binPath + ".classes"
+}
+
+// runCompiler runs name with args in workDir and returns its stderr; a
+// non-zero exit is reported as the caller's *CompileError.
+func runCompiler(ctx context.Context, name string, args []string, workDir string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = workDir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return stderr.Bytes(), err
+	}
+	return stderr.Bytes(), nil
+}
+
+func commandVersion(name string, args ...string) func(context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		cmd := exec.CommandContext(ctx, name, args...)
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			return "", fmt.Errorf("%s %v: %w", name, args, err)
+		}
+		return string(out), nil
+	}
+}
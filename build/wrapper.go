@@ -0,0 +1,40 @@
+package build
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// writeShebangWrapper writes a `#!/bin/sh` script to path that execs
+// interpreter with the given args followed by any arguments judge run
+// passes the submission, so an interpreted solution can be invoked
+// exactly like a compiled binary.
+func writeShebangWrapper(path, interpreter string, args ...string) error {
+	var b strings.Builder
+	fmt.Fprintln(&b, "#!/bin/sh")
+	fmt.Fprintf(&b, "exec %s", interpreter)
+	for _, a := range args {
+		fmt.Fprintf(&b, " %q", a)
+	}
+	fmt.Fprintln(&b, ` "$@"`)
+	return os.WriteFile(path, []byte(b.String()), 0o755)
+}
+
+// mainClassName finds the top-level .class file javac produced in
+// classDir (skipping inner classes, which contain '$') and returns its
+// name, suitable for `java -cp classDir <name>`.
+func mainClassName(classDir string) (string, error) {
+	entries, err := os.ReadDir(classDir)
+	if err != nil {
+		return "", err
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if strings.HasSuffix(name, ".class") && !strings.Contains(name, "$") {
+			return strings.TrimSuffix(name, filepath.Ext(name)), nil
+		}
+	}
+	return "", fmt.Errorf("javac produced no top-level .class file in %s", classDir)
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/yeizero/offline-judge-cli/build"
+)
+
+// defaultCacheDir is where compiled submissions are cached, keyed by
+// sha256(source)+toolchain-version, so repeated judge runs of the same
+// solution skip recompilation.
+func defaultCacheDir() string {
+	if dir, err := os.UserCacheDir(); err == nil {
+		return filepath.Join(dir, "offline-judge-cli", "build")
+	}
+	return filepath.Join(os.TempDir(), "offline-judge-cli-build")
+}
+
+// buildCommand implements `judge build SRC`: it compiles SRC with the
+// toolchain matched to its extension and prints the path to the
+// resulting binary.
+func buildCommand(args []string) error {
+	fs := flag.NewFlagSet("build", flag.ExitOnError)
+	cacheDir := fs.String("cache", defaultCacheDir(), "directory where compiled binaries are cached")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 1 {
+		return fmt.Errorf("usage: judge build SRC")
+	}
+
+	bin, err := resolveSubmission(fs.Arg(0), *cacheDir)
+	if err != nil {
+		return err
+	}
+	fmt.Println(bin)
+	return nil
+}
+
+// resolveSubmission returns a runnable binary for path: if path's
+// extension is a recognized source language, it is compiled (or pulled
+// from cache) via the build package; otherwise path is assumed to
+// already be a runnable binary and is returned unchanged.
+func resolveSubmission(path, cacheDir string) (string, error) {
+	if !build.Recognized(filepath.Ext(path)) {
+		return path, nil
+	}
+
+	bin, err := build.Build(context.Background(), path, cacheDir)
+	if err != nil {
+		var ce *build.CompileError
+		if errors.As(err, &ce) {
+			return "", fmt.Errorf("CE: %s", ce)
+		}
+		return "", err
+	}
+	return bin, nil
+}
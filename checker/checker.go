@@ -0,0 +1,53 @@
+// Package checker compares a submission's output against the expected
+// answer for a test case. Different problems need different notions of
+// "correct" — byte-exact, whitespace-insensitive, floating-point
+// tolerant, or a problem-specific special judge — so Checker is an
+// interface with one implementation per notion, selectable by name.
+package checker
+
+import (
+	"fmt"
+	"io"
+)
+
+// Checker decides whether actual is an acceptable answer given expected.
+// detail should describe the first point of disagreement when ok is
+// false, and is ignored when ok is true.
+type Checker interface {
+	Check(expected, actual io.Reader) (ok bool, detail string, err error)
+}
+
+// InputAware is implemented by checkers that need the test case's input
+// file in addition to the expected/actual streams (currently only SPJ).
+// `judge run` type-asserts for this after Lookup and binds the input
+// file for each case before calling Check.
+type InputAware interface {
+	WithInput(path string) Checker
+}
+
+// Options carries the parameters a built-in checker needs beyond the
+// two streams it compares.
+type Options struct {
+	Eps     float64 // tolerance for the float checker
+	SPJPath string  // special judge binary for the spj checker
+}
+
+// Lookup returns the Checker named by the `--checker` flag, configured
+// from opts.
+func Lookup(name string, opts Options) (Checker, error) {
+	switch name {
+	case "exact":
+		return Exact{}, nil
+	case "token":
+		return Token{}, nil
+	case "float":
+		return Float{Eps: opts.Eps}, nil
+	case "spj":
+		if opts.SPJPath == "" {
+			return nil, fmt.Errorf("checker %q requires --spj", name)
+		}
+		return SPJ{Path: opts.SPJPath}, nil
+	default:
+		return nil, fmt.Errorf("unknown checker %q", name)
+	}
+}
@@ -0,0 +1,109 @@
+package checker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExactCheck(t *testing.T) {
+	cases := []struct {
+		name             string
+		expected, actual string
+		ok               bool
+	}{
+		{"identical", "3\n", "3\n", true},
+		{"trailing whitespace differs", "3\n", "3", false},
+		{"content differs", "3\n", "4\n", false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ok, _, err := Exact{}.Check(strings.NewReader(c.expected), strings.NewReader(c.actual))
+			if err != nil {
+				t.Fatalf("Check: %v", err)
+			}
+			if ok != c.ok {
+				t.Fatalf("Check(%q, %q) = %v, want %v", c.expected, c.actual, ok, c.ok)
+			}
+		})
+	}
+}
+
+func TestTokenCheck(t *testing.T) {
+	cases := []struct {
+		name             string
+		expected, actual string
+		ok               bool
+	}{
+		{"exact match", "1 2 3\n", "1 2 3\n", true},
+		{"whitespace insensitive", "1 2 3\n", "1  2\t3\n\n", true},
+		{"mismatch reports position", "1 2 3\n", "1 2 4\n", false},
+		{"extra actual output", "1 2\n", "1 2 3\n", false},
+		{"truncated actual output", "1 2 3\n", "1 2\n", false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ok, detail, err := Token{}.Check(strings.NewReader(c.expected), strings.NewReader(c.actual))
+			if err != nil {
+				t.Fatalf("Check: %v", err)
+			}
+			if ok != c.ok {
+				t.Fatalf("Check(%q, %q) = %v, want %v", c.expected, c.actual, ok, c.ok)
+			}
+			if !ok && detail == "" {
+				t.Fatalf("Check(%q, %q) returned no detail for a mismatch", c.expected, c.actual)
+			}
+		})
+	}
+}
+
+func TestTokenCheckReportsLineAndColumn(t *testing.T) {
+	ok, detail, err := Token{}.Check(strings.NewReader("1 2\n3 4\n"), strings.NewReader("1 2\n3 5\n"))
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if ok {
+		t.Fatalf("Check returned ok, want a mismatch on line 2")
+	}
+	if !strings.Contains(detail, "line 2") || !strings.Contains(detail, "column 3") {
+		t.Fatalf("detail %q does not pinpoint line 2, column 3", detail)
+	}
+}
+
+func TestFloatCheck(t *testing.T) {
+	f := Float{Eps: 1e-6}
+
+	cases := []struct {
+		name             string
+		expected, actual string
+		ok               bool
+	}{
+		{"exact", "3.14\n", "3.14\n", true},
+		{"within tolerance", "3.14159265\n", "3.14159266\n", true},
+		{"outside tolerance", "1.0\n", "1.1\n", false},
+		{"non-numeric tokens compare exactly", "YES\n", "YES\n", true},
+		{"non-numeric mismatch", "YES\n", "NO\n", false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ok, _, err := f.Check(strings.NewReader(c.expected), strings.NewReader(c.actual))
+			if err != nil {
+				t.Fatalf("Check: %v", err)
+			}
+			if ok != c.ok {
+				t.Fatalf("Check(%q, %q) = %v, want %v", c.expected, c.actual, ok, c.ok)
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	if _, err := Lookup("exact", Options{}); err != nil {
+		t.Fatalf("Lookup(exact): %v", err)
+	}
+	if _, err := Lookup("bogus", Options{}); err == nil {
+		t.Fatal("Lookup(bogus) = nil error, want an error")
+	}
+	if _, err := Lookup("spj", Options{}); err == nil || !strings.Contains(err.Error(), "--spj") {
+		t.Fatalf("Lookup(spj) with no path = %v, want an error mentioning --spj", err)
+	}
+}
@@ -0,0 +1,41 @@
+package checker
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// Exact requires the two streams to be byte-for-byte identical.
+type Exact struct{}
+
+func (Exact) Check(expected, actual io.Reader) (bool, string, error) {
+	er := bufio.NewReader(expected)
+	ar := bufio.NewReader(actual)
+
+	pos := 0
+	for {
+		eb, eerr := er.ReadByte()
+		ab, aerr := ar.ReadByte()
+
+		if eerr == io.EOF && aerr == io.EOF {
+			return true, "", nil
+		}
+		if eerr != nil && eerr != io.EOF {
+			return false, "", eerr
+		}
+		if aerr != nil && aerr != io.EOF {
+			return false, "", aerr
+		}
+		if eerr == io.EOF {
+			return false, fmt.Sprintf("byte %d: expected EOF, got more output", pos), nil
+		}
+		if aerr == io.EOF {
+			return false, fmt.Sprintf("byte %d: unexpected EOF", pos), nil
+		}
+		if eb != ab {
+			return false, fmt.Sprintf("byte %d: expected %q, got %q", pos, eb, ab), nil
+		}
+		pos++
+	}
+}
@@ -0,0 +1,57 @@
+package checker
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// Float is like Token, but tokens that parse as float64 are compared
+// with an absolute-or-relative tolerance instead of exact string
+// matching, so rounding differences in the last few digits don't fail a
+// case. Tokens that don't parse as floats (e.g. "YES"/"NO") fall back to
+// exact comparison.
+type Float struct {
+	Eps float64
+}
+
+func (f Float) Check(expected, actual io.Reader) (bool, string, error) {
+	es := newTokenScanner(expected)
+	as := newTokenScanner(actual)
+
+	for {
+		eTok, eLine, eCol, eErr := es.next()
+		aTok, aLine, aCol, aErr := as.next()
+		if eErr != nil && eErr != io.EOF {
+			return false, "", eErr
+		}
+		if aErr != nil && aErr != io.EOF {
+			return false, "", aErr
+		}
+
+		if eErr == io.EOF && aErr == io.EOF {
+			return true, "", nil
+		}
+		if eErr == io.EOF {
+			return false, fmt.Sprintf("line %d, column %d: expected end of output, got %q", aLine, aCol, aTok), nil
+		}
+		if aErr == io.EOF {
+			return false, fmt.Sprintf("line %d, column %d: expected %q, got end of output", eLine, eCol, eTok), nil
+		}
+
+		ef, parseErr1 := strconv.ParseFloat(eTok, 64)
+		af, parseErr2 := strconv.ParseFloat(aTok, 64)
+		if parseErr1 != nil || parseErr2 != nil {
+			if eTok != aTok {
+				return false, fmt.Sprintf("line %d, column %d: expected %q, got %q", eLine, eCol, eTok, aTok), nil
+			}
+			continue
+		}
+
+		diff := math.Abs(ef - af)
+		if diff > f.Eps && diff > f.Eps*math.Abs(ef) {
+			return false, fmt.Sprintf("line %d, column %d: expected %v, got %v (diff %v > eps %v)", eLine, eCol, ef, af, diff, f.Eps), nil
+		}
+	}
+}
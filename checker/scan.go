@@ -0,0 +1,65 @@
+package checker
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// tokenScanner splits a stream into whitespace-separated tokens like
+// bufio.ScanWords, but additionally tracks the 1-based line and column
+// each token starts at, so checkers can report exactly where two
+// streams diverge.
+type tokenScanner struct {
+	r    *bufio.Reader
+	line int
+	col  int
+}
+
+func newTokenScanner(r io.Reader) *tokenScanner {
+	return &tokenScanner{r: bufio.NewReader(r), line: 1, col: 1}
+}
+
+// next returns the next token and the line/col it starts at. It returns
+// io.EOF once the stream is exhausted.
+func (s *tokenScanner) next() (tok string, line, col int, err error) {
+	for {
+		ru, _, err := s.r.ReadRune()
+		if err == io.EOF {
+			return "", 0, 0, io.EOF
+		}
+		if err != nil {
+			return "", 0, 0, err
+		}
+		if !unicode.IsSpace(ru) {
+			s.r.UnreadRune()
+			break
+		}
+		if ru == '\n' {
+			s.line++
+			s.col = 1
+		} else {
+			s.col++
+		}
+	}
+
+	line, col = s.line, s.col
+	var b strings.Builder
+	for {
+		ru, _, err := s.r.ReadRune()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", 0, 0, err
+		}
+		if unicode.IsSpace(ru) {
+			s.r.UnreadRune()
+			break
+		}
+		b.WriteRune(ru)
+		s.col++
+	}
+	return b.String(), line, col, nil
+}
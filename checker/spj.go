@@ -0,0 +1,67 @@
+package checker
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+)
+
+// SPJ delegates the comparison to an external "special judge" binary,
+// for problems where correctness isn't expressible as a simple diff
+// (multiple valid answers, constructive problems, ...). Path is invoked
+// as `spj expected actual input` and its exit code is interpreted: 0
+// means AC, 1 means WA, 2 means presentation error (treated as WA with
+// a distinguishing detail), and anything else is reported as an error.
+type SPJ struct {
+	Path  string
+	Input string // path to the test case's input file, passed to the spj for context
+}
+
+// WithInput returns a copy of s bound to the given test case input
+// file. It satisfies checker.InputAware.
+func (s SPJ) WithInput(path string) Checker {
+	s.Input = path
+	return s
+}
+
+func (s SPJ) Check(expected, actual io.Reader) (bool, string, error) {
+	expectedFile, err := spoolToTemp(expected, "expected")
+	if err != nil {
+		return false, "", err
+	}
+	defer os.Remove(expectedFile)
+
+	actualFile, err := spoolToTemp(actual, "actual")
+	if err != nil {
+		return false, "", err
+	}
+	defer os.Remove(actualFile)
+
+	cmd := exec.Command(s.Path, expectedFile, actualFile, s.Input)
+	output, err := cmd.CombinedOutput()
+
+	switch {
+	case err == nil:
+		return true, "", nil
+	case cmd.ProcessState.ExitCode() == 1:
+		return false, string(output), nil
+	case cmd.ProcessState.ExitCode() == 2:
+		return false, fmt.Sprintf("presentation error: %s", output), nil
+	default:
+		return false, "", fmt.Errorf("special judge %s: %w: %s", s.Path, err, output)
+	}
+}
+
+func spoolToTemp(r io.Reader, prefix string) (string, error) {
+	f, err := os.CreateTemp("", "judge-spj-"+prefix+"-*")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, r); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
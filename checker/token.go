@@ -0,0 +1,39 @@
+package checker
+
+import (
+	"fmt"
+	"io"
+)
+
+// Token splits both streams into whitespace-separated tokens, so
+// trailing whitespace and differing line endings don't cause a mismatch.
+type Token struct{}
+
+func (Token) Check(expected, actual io.Reader) (bool, string, error) {
+	es := newTokenScanner(expected)
+	as := newTokenScanner(actual)
+
+	for {
+		eTok, eLine, eCol, eErr := es.next()
+		aTok, aLine, aCol, aErr := as.next()
+		if eErr != nil && eErr != io.EOF {
+			return false, "", eErr
+		}
+		if aErr != nil && aErr != io.EOF {
+			return false, "", aErr
+		}
+
+		if eErr == io.EOF && aErr == io.EOF {
+			return true, "", nil
+		}
+		if eErr == io.EOF {
+			return false, fmt.Sprintf("line %d, column %d: expected end of output, got %q", aLine, aCol, aTok), nil
+		}
+		if aErr == io.EOF {
+			return false, fmt.Sprintf("line %d, column %d: expected %q, got end of output", eLine, eCol, eTok), nil
+		}
+		if eTok != aTok {
+			return false, fmt.Sprintf("line %d, column %d: expected %q, got %q", eLine, eCol, eTok, aTok), nil
+		}
+	}
+}
@@ -0,0 +1,22 @@
+// Command aplusb is the classic "read two integers, print their sum"
+// sample solution. It exists as a self-test fixture for the judge CLI:
+// `judge run --tests examples/aplusb/tests <built aplusb binary>` should
+// pass every case under tests/.
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+)
+
+func main() {
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		var a, b int
+		_, err := fmt.Sscanf(scanner.Text(), "%d %d", &a, &b)
+		if err == nil {
+			fmt.Println(a + b)
+		}
+	}
+}
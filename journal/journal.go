@@ -0,0 +1,146 @@
+// Package journal appends and reads back machine-readable verdict
+// records in recfile format with TAI64N timestamps (the format and
+// timestamp convention goredo uses), one record per test case run, so
+// regressions in a solution can be tracked across many invocations of
+// `judge run`.
+package journal
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+)
+
+// maxFieldBytes bounds how much of a failing case's stdout/stderr/diff
+// is copied into the journal, so one pathological case can't blow up
+// the log file.
+const maxFieldBytes = 4096
+
+// Record is one test-case run, as appended to the journal by `judge
+// run --log`.
+type Record struct {
+	When     time.Time
+	Problem  string
+	Case     string
+	Verdict  string
+	WallMs   int64
+	CPUMs    int64
+	MaxRSSKB int64
+	ExitCode int
+	Stdout   string // only set on failure
+	Stderr   string // only set on failure
+	Diff     string // only set on failure
+}
+
+// Append writes rec to the journal at path, creating it if necessary.
+func Append(path string, rec Record) error {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := newRecWriter(f)
+	w.writeField("When", formatTAI64N(rec.When))
+	w.writeField("Problem", rec.Problem)
+	w.writeField("Case", rec.Case)
+	w.writeField("Verdict", rec.Verdict)
+	w.writeField("WallMs", strconv.FormatInt(rec.WallMs, 10))
+	w.writeField("CpuMs", strconv.FormatInt(rec.CPUMs, 10))
+	w.writeField("MaxRssKb", strconv.FormatInt(rec.MaxRSSKB, 10))
+	w.writeField("ExitCode", strconv.Itoa(rec.ExitCode))
+	if rec.Verdict != "AC" {
+		if rec.Stdout != "" {
+			w.writeFieldMultiline("Stdout", truncate(rec.Stdout))
+		}
+		if rec.Stderr != "" {
+			w.writeFieldMultiline("Stderr", truncate(rec.Stderr))
+		}
+		if rec.Diff != "" {
+			w.writeFieldMultiline("Diff", truncate(rec.Diff))
+		}
+	}
+	w.endRecord()
+	return nil
+}
+
+// ReadAll parses every record in the journal at path.
+func ReadAll(path string) ([]Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := newRecReader(f)
+	var records []Record
+	for {
+		fields, err := r.next()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		rec, err := recordFromFields(fields)
+		if err != nil {
+			return nil, fmt.Errorf("journal record %d: %w", len(records)+1, err)
+		}
+		records = append(records, rec)
+	}
+}
+
+func recordFromFields(fields map[string]string) (Record, error) {
+	get := func(name string) string { return fields[name] }
+	getInt := func(name string) (int64, error) {
+		s := get(name)
+		if s == "" {
+			return 0, nil
+		}
+		return strconv.ParseInt(s, 10, 64)
+	}
+
+	when, err := parseTAI64N(get("When"))
+	if err != nil {
+		return Record{}, fmt.Errorf("When: %w", err)
+	}
+	wallMs, err := getInt("WallMs")
+	if err != nil {
+		return Record{}, fmt.Errorf("WallMs: %w", err)
+	}
+	cpuMs, err := getInt("CpuMs")
+	if err != nil {
+		return Record{}, fmt.Errorf("CpuMs: %w", err)
+	}
+	maxRSS, err := getInt("MaxRssKb")
+	if err != nil {
+		return Record{}, fmt.Errorf("MaxRssKb: %w", err)
+	}
+	exitCode, err := getInt("ExitCode")
+	if err != nil {
+		return Record{}, fmt.Errorf("ExitCode: %w", err)
+	}
+
+	return Record{
+		When:     when,
+		Problem:  get("Problem"),
+		Case:     get("Case"),
+		Verdict:  get("Verdict"),
+		WallMs:   wallMs,
+		CPUMs:    cpuMs,
+		MaxRSSKB: maxRSS,
+		ExitCode: int(exitCode),
+		Stdout:   get("Stdout"),
+		Stderr:   get("Stderr"),
+		Diff:     get("Diff"),
+	}, nil
+}
+
+func truncate(s string) string {
+	if len(s) <= maxFieldBytes {
+		return s
+	}
+	return s[:maxFieldBytes] + "... (truncated)"
+}
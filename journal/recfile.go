@@ -0,0 +1,91 @@
+package journal
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// recfile is a small, dependency-free writer/reader for the recfile
+// format: one "Field: value" line per field, a blank line between
+// records, and "+ " continuation lines for multi-line values. It
+// replaces an earlier dependency on an external recfile library that
+// isn't reachable from every build environment this CLI ships from.
+
+type recWriter struct {
+	w io.Writer
+}
+
+func newRecWriter(w io.Writer) *recWriter { return &recWriter{w: w} }
+
+// writeField writes a single-line field.
+func (rw *recWriter) writeField(name, value string) {
+	fmt.Fprintf(rw.w, "%s: %s\n", name, value)
+}
+
+// writeFieldMultiline writes value as a field whose lines are each
+// prefixed with "+ ", the recfile convention for multi-line values.
+func (rw *recWriter) writeFieldMultiline(name, value string) {
+	fmt.Fprintf(rw.w, "%s:\n", name)
+	for _, line := range strings.Split(value, "\n") {
+		fmt.Fprintf(rw.w, "+ %s\n", line)
+	}
+}
+
+// endRecord terminates the current record with the blank line that
+// separates it from the next one.
+func (rw *recWriter) endRecord() {
+	fmt.Fprintln(rw.w)
+}
+
+type recReader struct {
+	sc *bufio.Scanner
+}
+
+func newRecReader(r io.Reader) *recReader {
+	return &recReader{sc: bufio.NewScanner(r)}
+}
+
+// next reads the next record's fields, keyed by field name. It returns
+// io.EOF once there are no more records.
+func (rr *recReader) next() (map[string]string, error) {
+	fields := map[string]string{}
+	var lastField string
+	sawAny := false
+
+	for rr.sc.Scan() {
+		line := rr.sc.Text()
+		if line == "" {
+			if sawAny {
+				return fields, nil
+			}
+			continue
+		}
+		sawAny = true
+
+		if strings.HasPrefix(line, "+ ") {
+			cont := strings.TrimPrefix(line, "+ ")
+			if fields[lastField] == "" {
+				fields[lastField] = cont
+			} else {
+				fields[lastField] += "\n" + cont
+			}
+			continue
+		}
+
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("malformed recfile line %q", line)
+		}
+		fields[name] = strings.TrimPrefix(value, " ")
+		lastField = name
+	}
+	if err := rr.sc.Err(); err != nil {
+		return nil, err
+	}
+	if sawAny {
+		return fields, nil
+	}
+	return nil, io.EOF
+}
@@ -0,0 +1,47 @@
+package journal
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// tai64nEpoch is the offset between the TAI64 epoch (1970-01-01 TAI)
+// and the conventional label encoding: second 0 is labeled 2^62.
+const tai64nEpoch = 1 << 62
+
+// formatTAI64N renders t in external TAI64N format: '@' followed by 24
+// lowercase hex digits — 8 bytes of seconds since the TAI64 epoch, then
+// 4 bytes of nanoseconds.
+func formatTAI64N(t time.Time) string {
+	sec := uint64(t.Unix()) + tai64nEpoch
+	nsec := uint32(t.Nanosecond())
+
+	var buf [12]byte
+	for i := 0; i < 8; i++ {
+		buf[7-i] = byte(sec >> (8 * i))
+	}
+	for i := 0; i < 4; i++ {
+		buf[11-i] = byte(nsec >> (8 * i))
+	}
+	return "@" + hex.EncodeToString(buf[:])
+}
+
+// parseTAI64N parses a label written by formatTAI64N.
+func parseTAI64N(s string) (time.Time, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(s, "@"))
+	if err != nil || len(raw) != 12 {
+		return time.Time{}, fmt.Errorf("invalid TAI64N label %q", s)
+	}
+
+	var sec uint64
+	for i := 0; i < 8; i++ {
+		sec = sec<<8 | uint64(raw[i])
+	}
+	var nsec uint32
+	for i := 8; i < 12; i++ {
+		nsec = nsec<<8 | uint32(raw[i])
+	}
+	return time.Unix(int64(sec-tai64nEpoch), int64(nsec)).UTC(), nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/yeizero/offline-judge-cli/journal"
+)
+
+// logCommand implements `judge log tail|stats <file>`, both of which
+// read back the recfile journal written by `judge run --log`.
+func logCommand(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("usage: judge log <tail|stats> FILE")
+	}
+	switch args[0] {
+	case "tail":
+		return logTail(args[1:])
+	case "stats":
+		return logStats(args[1:])
+	default:
+		return fmt.Errorf("usage: judge log <tail|stats> FILE")
+	}
+}
+
+func logTail(args []string) error {
+	fs := flag.NewFlagSet("log tail", flag.ExitOnError)
+	n := fs.Int("n", 20, "number of records to print")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 1 {
+		return fmt.Errorf("usage: judge log tail [-n N] FILE")
+	}
+
+	records, err := journal.ReadAll(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if *n < len(records) {
+		records = records[len(records)-*n:]
+	}
+	for _, r := range records {
+		fmt.Printf("%s  %-20s %-8s %-4s %6dms %6dKB\n",
+			r.When.Format(time.RFC3339), r.Problem, r.Case, r.Verdict, r.WallMs, r.MaxRSSKB)
+	}
+	return nil
+}
+
+func logStats(args []string) error {
+	fs := flag.NewFlagSet("log stats", flag.ExitOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 1 {
+		return fmt.Errorf("usage: judge log stats FILE")
+	}
+
+	records, err := journal.ReadAll(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	counts := map[string]int{}
+	var totalWall int64
+	for _, r := range records {
+		counts[r.Verdict]++
+		totalWall += r.WallMs
+	}
+
+	fmt.Printf("%d runs recorded\n", len(records))
+	for _, v := range []string{"AC", "WA", "RE", "TLE"} {
+		if counts[v] > 0 {
+			fmt.Printf("  %-4s %d\n", v, counts[v])
+		}
+	}
+	if len(records) > 0 {
+		fmt.Printf("avg wall time: %dms\n", totalWall/int64(len(records)))
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Command judge is an offline programming-contest judge: it runs a
+// submission binary against a directory of test cases and reports a
+// verdict for each one.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/yeizero/offline-judge-cli/runner"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == runner.ExecHelperArg {
+		if err := runner.RunExecHelper(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, "judge:", err)
+			os.Exit(127)
+		}
+		return
+	}
+
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "run":
+		err = runCommand(os.Args[2:])
+	case "build":
+		err = buildCommand(os.Args[2:])
+	case "log":
+		err = logCommand(os.Args[2:])
+	case "help", "-h", "--help":
+		usage()
+	default:
+		fmt.Fprintf(os.Stderr, "judge: unknown command %q\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "judge:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: judge <command> [arguments]
+
+commands:
+  run    run a submission against a directory of test cases
+  build  compile a submission source file and print the resulting binary
+  log    inspect a verdict journal written by judge run --log`)
+}
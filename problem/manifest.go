@@ -0,0 +1,45 @@
+package problem
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// manifest is the on-disk shape of problem.yaml: problem metadata plus
+// the list of test cases and the SHA-512 hash each file must match.
+type manifest struct {
+	Title       string         `yaml:"title"`
+	TimeLimit   time.Duration  `yaml:"time_limit"`
+	MemoryLimit string         `yaml:"memory_limit"` // e.g. "256MB", parsed by parseMemoryLimit
+	Checker     string         `yaml:"checker"`
+	Languages   []string       `yaml:"languages"`
+	Cases       []manifestCase `yaml:"cases"`
+}
+
+type manifestCase struct {
+	Name string       `yaml:"name"`
+	In   manifestFile `yaml:"in"`
+	Out  manifestFile `yaml:"out"`
+}
+
+type manifestFile struct {
+	Path   string `yaml:"path"`
+	SHA512 string `yaml:"sha512"`
+}
+
+func parseManifest(r io.Reader) (*manifest, error) {
+	var m manifest
+	if err := yaml.NewDecoder(r).Decode(&m); err != nil {
+		return nil, fmt.Errorf("parse problem.yaml: %w", err)
+	}
+	if m.Title == "" {
+		return nil, fmt.Errorf("problem.yaml: title is required")
+	}
+	if len(m.Cases) == 0 {
+		return nil, fmt.Errorf("problem.yaml: at least one case is required")
+	}
+	return &m, nil
+}
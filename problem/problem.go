@@ -0,0 +1,178 @@
+// Package problem loads a judge problem package — a directory or
+// archive containing a problem.yaml manifest and its test cases — and
+// verifies every test file against the SHA-512 hash recorded in the
+// manifest before handing it to the runner.
+package problem
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Case is one verified test case belonging to a Problem.
+type Case struct {
+	Name    string
+	InPath  string
+	OutPath string
+}
+
+// Problem is a loaded, hash-verified problem package.
+type Problem struct {
+	Title string
+	// TimeLimit is zero when problem.yaml omits time_limit; callers
+	// must not treat zero as "unlimited" and should fall back to their
+	// own default instead.
+	TimeLimit   time.Duration
+	MemoryBytes int64
+	Checker     string
+	Languages   []string
+	Cases       []Case
+
+	dir     string
+	cleanup func()
+}
+
+// Dir returns the directory the problem's files were loaded from or
+// extracted into.
+func (p *Problem) Dir() string { return p.dir }
+
+// Close releases any temporary directory created while extracting an
+// archive. It is a no-op for problems loaded directly from a directory.
+func (p *Problem) Close() error {
+	if p.cleanup != nil {
+		p.cleanup()
+	}
+	return nil
+}
+
+// Load reads a problem package from path, which may be a directory or a
+// .tar/.tar.gz/.tar.zst archive, and verifies every test file against
+// the SHA-512 hash recorded in problem.yaml. It returns an error instead
+// of a Problem if any file is missing or its hash doesn't match.
+func Load(path string) (*Problem, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := path
+	var cleanup func()
+	if !info.IsDir() {
+		tmp, err := os.MkdirTemp("", "judge-problem-*")
+		if err != nil {
+			return nil, err
+		}
+		if err := extractArchive(path, tmp); err != nil {
+			os.RemoveAll(tmp)
+			return nil, err
+		}
+		dir = tmp
+		cleanup = func() { os.RemoveAll(tmp) }
+	}
+
+	p, err := loadDir(dir)
+	if err != nil {
+		if cleanup != nil {
+			cleanup()
+		}
+		return nil, err
+	}
+	p.cleanup = cleanup
+	return p, nil
+}
+
+func loadDir(dir string) (*Problem, error) {
+	f, err := os.Open(filepath.Join(dir, "problem.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m, err := parseManifest(f)
+	if err != nil {
+		return nil, err
+	}
+
+	memBytes, err := parseMemoryLimit(m.MemoryLimit)
+	if err != nil {
+		return nil, fmt.Errorf("problem.yaml: memory_limit: %w", err)
+	}
+
+	p := &Problem{
+		Title:       m.Title,
+		TimeLimit:   m.TimeLimit,
+		MemoryBytes: memBytes,
+		Checker:     m.Checker,
+		Languages:   m.Languages,
+		dir:         dir,
+	}
+
+	for _, mc := range m.Cases {
+		inPath, err := verifyFile(dir, mc.In)
+		if err != nil {
+			return nil, fmt.Errorf("case %s: %w", mc.Name, err)
+		}
+		outPath, err := verifyFile(dir, mc.Out)
+		if err != nil {
+			return nil, fmt.Errorf("case %s: %w", mc.Name, err)
+		}
+		p.Cases = append(p.Cases, Case{Name: mc.Name, InPath: inPath, OutPath: outPath})
+	}
+
+	return p, nil
+}
+
+// verifyFile resolves mf.Path relative to dir and streams it through
+// SHA-512, returning an error if the digest doesn't match mf.SHA512.
+func verifyFile(dir string, mf manifestFile) (string, error) {
+	path := filepath.Join(dir, mf.Path)
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha512.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	got := hex.EncodeToString(h.Sum(nil))
+	if !strings.EqualFold(got, mf.SHA512) {
+		return "", fmt.Errorf("%s: sha512 mismatch: manifest says %s, got %s", mf.Path, mf.SHA512, got)
+	}
+	return path, nil
+}
+
+// parseMemoryLimit parses sizes like "256MB", "1GB", or "524288KB" into
+// bytes. An empty string means unlimited (0).
+func parseMemoryLimit(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	units := []struct {
+		suffix string
+		factor int64
+	}{
+		{"GB", 1 << 30},
+		{"MB", 1 << 20},
+		{"KB", 1 << 10},
+		{"B", 1},
+	}
+	for _, u := range units {
+		if strings.HasSuffix(s, u.suffix) {
+			n, err := strconv.ParseInt(strings.TrimSuffix(s, u.suffix), 10, 64)
+			if err != nil {
+				return 0, err
+			}
+			return n * u.factor, nil
+		}
+	}
+	return 0, fmt.Errorf("unrecognized size %q", s)
+}
@@ -0,0 +1,117 @@
+package problem
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func sha512Hex(t *testing.T, data string) string {
+	t.Helper()
+	sum := sha512.Sum512([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+func writeProblemDir(t *testing.T, timeLimitLine string) string {
+	t.Helper()
+	dir := t.TempDir()
+
+	in, out := "1 2\n", "3\n"
+	if err := os.WriteFile(filepath.Join(dir, "1.in"), []byte(in), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "1.out"), []byte(out), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest := fmt.Sprintf(`title: A+B
+%schecker: token
+cases:
+  - name: "1"
+    in:
+      path: 1.in
+      sha512: %s
+    out:
+      path: 1.out
+      sha512: %s
+`, timeLimitLine, sha512Hex(t, in), sha512Hex(t, out))
+
+	if err := os.WriteFile(filepath.Join(dir, "problem.yaml"), []byte(manifest), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestLoadValidProblem(t *testing.T) {
+	dir := writeProblemDir(t, "time_limit: 2s\n")
+
+	p, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	defer p.Close()
+
+	if p.Title != "A+B" {
+		t.Errorf("Title = %q, want A+B", p.Title)
+	}
+	if len(p.Cases) != 1 {
+		t.Fatalf("len(Cases) = %d, want 1", len(p.Cases))
+	}
+	if p.TimeLimit.Seconds() != 2 {
+		t.Errorf("TimeLimit = %s, want 2s", p.TimeLimit)
+	}
+}
+
+func TestLoadMissingTimeLimitIsZero(t *testing.T) {
+	dir := writeProblemDir(t, "")
+
+	p, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	defer p.Close()
+
+	if p.TimeLimit != 0 {
+		t.Errorf("TimeLimit = %s, want 0 so callers fall back to their own default", p.TimeLimit)
+	}
+}
+
+func TestLoadRejectsHashMismatch(t *testing.T) {
+	dir := writeProblemDir(t, "time_limit: 2s\n")
+	if err := os.WriteFile(filepath.Join(dir, "1.in"), []byte("tampered\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Load(dir); err == nil {
+		t.Fatal("Load succeeded on a tampered test file, want a hash-mismatch error")
+	}
+}
+
+func TestParseMemoryLimit(t *testing.T) {
+	cases := []struct {
+		in   string
+		want int64
+	}{
+		{"", 0},
+		{"256MB", 256 << 20},
+		{"1GB", 1 << 30},
+		{"512KB", 512 << 10},
+	}
+	for _, c := range cases {
+		got, err := parseMemoryLimit(c.in)
+		if err != nil {
+			t.Errorf("parseMemoryLimit(%q): %v", c.in, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("parseMemoryLimit(%q) = %d, want %d", c.in, got, c.want)
+		}
+	}
+
+	if _, err := parseMemoryLimit("lots"); err == nil {
+		t.Error(`parseMemoryLimit("lots") = nil error, want an error`)
+	}
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yeizero/offline-judge-cli/checker"
+	"github.com/yeizero/offline-judge-cli/journal"
+	"github.com/yeizero/offline-judge-cli/problem"
+	"github.com/yeizero/offline-judge-cli/runner"
+)
+
+// testCase is a single "N.in" / "N.out" pair discovered in a test-case
+// directory.
+type testCase struct {
+	name    string
+	inPath  string
+	outPath string
+}
+
+// runCommand implements `judge run [flags] SUBMISSION`: it discovers the
+// test cases under --tests, runs SUBMISSION against each one under the
+// given resource limits, and prints a verdict line per case followed by
+// a summary.
+func runCommand(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	testsDir := fs.String("tests", "", "directory containing N.in/N.out test case pairs")
+	problemPath := fs.String("problem", "", "problem package (directory or .tar/.tar.gz/.tar.zst archive) to load instead of --tests")
+	timeLimit := fs.Duration("time-limit", 2*time.Second, "wall-clock limit per test case")
+	cpuLimit := fs.Duration("cpu-limit", 0, "CPU-time limit per test case (unix only, 0 = unlimited)")
+	memLimitMB := fs.Int64("mem-limit", 0, "address-space limit per test case, in megabytes (unix only, 0 = unlimited)")
+	maxOutputKB := fs.Int64("max-output-bytes", 64*1024, "captured stdout/stderr is truncated beyond this many bytes")
+	checkerName := fs.String("checker", "token", "output checker: exact, token, float, or spj")
+	eps := fs.Float64("eps", 1e-6, "absolute/relative tolerance for the float checker")
+	spjPath := fs.String("spj", "", "path to the special judge binary (required when --checker=spj)")
+	logPath := fs.String("log", "", "append a recfile verdict record per case to this file")
+	cacheDir := fs.String("cache", defaultCacheDir(), "directory where compiled submissions are cached")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 1 {
+		return fmt.Errorf("usage: judge run [flags] SUBMISSION")
+	}
+	if *testsDir == "" && *problemPath == "" {
+		return fmt.Errorf("one of --tests or --problem is required")
+	}
+
+	submission, err := resolveSubmission(fs.Arg(0), *cacheDir)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "CE:") {
+			fmt.Println(CE)
+			fmt.Println(strings.TrimPrefix(err.Error(), "CE: "))
+			os.Exit(1)
+		}
+		return err
+	}
+
+	var cases []testCase
+	limits := runner.Limits{
+		Wall:           *timeLimit,
+		CPU:            *cpuLimit,
+		MemoryBytes:    *memLimitMB * 1024 * 1024,
+		MaxOutputBytes: *maxOutputKB,
+	}
+
+	if *problemPath != "" {
+		p, err := problem.Load(*problemPath)
+		if err != nil {
+			return fmt.Errorf("load problem: %w", err)
+		}
+		defer p.Close()
+
+		// The problem package's manifest is authoritative for the limits
+		// and checker it ships with; --cpu-limit, --max-output-bytes,
+		// --eps and --spj still apply on top of it. A manifest that
+		// omits time_limit or memory_limit falls back to --time-limit
+		// or --mem-limit rather than disabling that limit outright.
+		if p.TimeLimit > 0 {
+			limits.Wall = p.TimeLimit
+		}
+		if p.MemoryBytes > 0 {
+			limits.MemoryBytes = p.MemoryBytes
+		}
+		*checkerName = p.Checker
+		for _, c := range p.Cases {
+			cases = append(cases, testCase{name: c.Name, inPath: c.InPath, outPath: c.OutPath})
+		}
+	} else {
+		var err error
+		cases, err = findCases(*testsDir)
+		if err != nil {
+			return err
+		}
+	}
+	if len(cases) == 0 {
+		return fmt.Errorf("no test cases found")
+	}
+
+	chk, err := checker.Lookup(*checkerName, checker.Options{Eps: *eps, SPJPath: *spjPath})
+	if err != nil {
+		return err
+	}
+
+	problemName := *problemPath
+	if problemName == "" {
+		problemName = *testsDir
+	}
+
+	passed := 0
+	for _, c := range cases {
+		r := runCase(submission, c, limits, chk)
+		fmt.Printf("%-12s %-3s  %6s\n", r.Name, r.Verdict, r.Detail)
+		if r.Verdict == AC {
+			passed++
+		}
+		if *logPath != "" {
+			if err := appendJournal(*logPath, problemName, r); err != nil {
+				return fmt.Errorf("write log: %w", err)
+			}
+		}
+	}
+
+	fmt.Printf("\n%d/%d passed\n", passed, len(cases))
+	if passed != len(cases) {
+		os.Exit(1)
+	}
+	return nil
+}
+
+// findCases globs DIR for "*.in" files and keeps the ones with a
+// matching "*.out", sorted by name.
+func findCases(dir string) ([]testCase, error) {
+	ins, err := filepath.Glob(filepath.Join(dir, "*.in"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(ins)
+
+	var cases []testCase
+	for _, in := range ins {
+		base := strings.TrimSuffix(in, ".in")
+		out := base + ".out"
+		if _, err := os.Stat(out); err != nil {
+			continue
+		}
+		cases = append(cases, testCase{
+			name:    filepath.Base(base),
+			inPath:  in,
+			outPath: out,
+		})
+	}
+	return cases, nil
+}
+
+// runCase runs submission against c under limits and checks its stdout
+// against the expected output using chk.
+func runCase(submission string, c testCase, limits runner.Limits, chk checker.Checker) CaseResult {
+	in, err := os.Open(c.inPath)
+	if err != nil {
+		return CaseResult{Name: c.name, Verdict: RE, Detail: err.Error()}
+	}
+	defer in.Close()
+
+	expected, err := os.Open(c.outPath)
+	if err != nil {
+		return CaseResult{Name: c.name, Verdict: RE, Detail: err.Error()}
+	}
+	defer expected.Close()
+
+	res := runner.Run(context.Background(), submission, nil, in, limits)
+	base := CaseResult{
+		Name:     c.name,
+		Wall:     res.Wall,
+		CPU:      res.CPU,
+		MaxRSSKB: res.MaxRSSKB,
+		ExitCode: res.ExitCode,
+		Stdout:   string(res.Stdout),
+		Stderr:   string(res.Stderr),
+	}
+
+	switch res.Verdict {
+	case runner.TLE:
+		base.Verdict, base.Detail = TLE, res.Wall.String()
+		return base
+	case runner.RE:
+		base.Verdict, base.Detail = RE, res.Err.Error()
+		return base
+	}
+
+	if ia, ok := chk.(checker.InputAware); ok {
+		chk = ia.WithInput(c.inPath)
+	}
+
+	ok, detail, err := chk.Check(expected, bytes.NewReader(res.Stdout))
+	if err != nil {
+		base.Verdict, base.Detail = RE, err.Error()
+		return base
+	}
+	if !ok {
+		base.Verdict, base.Detail = WA, detail
+		return base
+	}
+	base.Verdict = AC
+	base.Detail = fmt.Sprintf("%s %dKB", res.Wall.Round(time.Millisecond), res.MaxRSSKB)
+	return base
+}
+
+// appendJournal records r as a journal.Record at path, including
+// stdout/stderr only when the case didn't pass.
+func appendJournal(path, problemName string, r CaseResult) error {
+	rec := journal.Record{
+		When:     time.Now(),
+		Problem:  problemName,
+		Case:     r.Name,
+		Verdict:  string(r.Verdict),
+		WallMs:   r.Wall.Milliseconds(),
+		CPUMs:    r.CPU.Milliseconds(),
+		MaxRSSKB: r.MaxRSSKB,
+		ExitCode: r.ExitCode,
+	}
+	if r.Verdict != AC {
+		rec.Stdout = r.Stdout
+		rec.Stderr = r.Stderr
+		rec.Diff = r.Detail
+	}
+	return journal.Append(path, rec)
+}
@@ -0,0 +1,22 @@
+package runner
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+// TestMain lets the compiled test binary stand in for the judge binary
+// when startWithLimits re-execs "itself" with ExecHelperArg, mirroring
+// main.go's own dispatch so the CPU/memory-limit path can be exercised
+// end-to-end without a separately built judge binary.
+func TestMain(m *testing.M) {
+	if len(os.Args) > 1 && os.Args[1] == ExecHelperArg {
+		if err := RunExecHelper(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(127)
+		}
+		return
+	}
+	os.Exit(m.Run())
+}
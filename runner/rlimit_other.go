@@ -0,0 +1,22 @@
+//go:build !unix
+
+package runner
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+// startWithLimits is a no-op on platforms without rlimit support: the
+// wall-clock limit (enforced via context) still applies, but CPU and
+// memory limits are silently not enforced.
+func startWithLimits(cmd *exec.Cmd, limits Limits) error {
+	return cmd.Start()
+}
+
+// RunExecHelper exists so main's dispatch code can reference it on
+// every platform; startWithLimits never re-execs into it here, since
+// there are no rlimits to apply.
+func RunExecHelper(args []string) error {
+	return fmt.Errorf("%s: not supported on this platform", ExecHelperArg)
+}
@@ -0,0 +1,96 @@
+//go:build unix
+
+package runner
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// startWithLimits starts cmd with limits.CPU and limits.MemoryBytes
+// enforced. RLIMIT_CPU and RLIMIT_AS are process-wide, not per-thread,
+// so lowering them on the calling goroutine's own thread — even with
+// runtime.LockOSThread, which Go gives no fork-without-exec hook
+// around — would apply to every other thread in the judge process
+// too (GC workers, other goroutines), risking the judge itself getting
+// OOM-killed or SIGXCPU'd under a tight limit. Instead, cmd is
+// re-exec'd through this same binary as a tiny helper: a fresh,
+// single-threaded process that lowers its own rlimits and then execs
+// the real submission in its place, so the limits never touch the
+// judge process at all.
+func startWithLimits(cmd *exec.Cmd, limits Limits) error {
+	if limits.CPU <= 0 && limits.MemoryBytes <= 0 {
+		return cmd.Start()
+	}
+
+	self, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	var cpuSeconds uint64
+	if limits.CPU > 0 {
+		cpuSeconds = uint64(limits.CPU / time.Second)
+		if cpuSeconds == 0 {
+			cpuSeconds = 1
+		}
+	}
+	var memBytes uint64
+	if limits.MemoryBytes > 0 {
+		memBytes = uint64(limits.MemoryBytes)
+	}
+
+	helperArgs := append([]string{
+		ExecHelperArg,
+		strconv.FormatUint(cpuSeconds, 10),
+		strconv.FormatUint(memBytes, 10),
+	}, cmd.Args...)
+
+	cmd.Path = self
+	cmd.Args = append([]string{self}, helperArgs...)
+	return cmd.Start()
+}
+
+// RunExecHelper is the helper process startWithLimits re-execs into.
+// args is [cpuSeconds, memoryBytes, binary, binaryArgs...], where a
+// "0" cpuSeconds/memoryBytes means unlimited. It only returns on
+// error: success replaces this process's image with binary entirely,
+// so the rlimits it just set apply to binary and nothing else.
+func RunExecHelper(args []string) error {
+	if len(args) < 3 {
+		return fmt.Errorf("%s: expected cpuSeconds memoryBytes binary [args...]", ExecHelperArg)
+	}
+
+	cpuSeconds, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("%s: invalid cpu seconds %q: %w", ExecHelperArg, args[0], err)
+	}
+	memBytes, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("%s: invalid memory bytes %q: %w", ExecHelperArg, args[1], err)
+	}
+
+	if cpuSeconds > 0 {
+		limit := syscall.Rlimit{Cur: cpuSeconds, Max: cpuSeconds}
+		if err := syscall.Setrlimit(syscall.RLIMIT_CPU, &limit); err != nil {
+			return fmt.Errorf("%s: setrlimit CPU: %w", ExecHelperArg, err)
+		}
+	}
+	if memBytes > 0 {
+		limit := syscall.Rlimit{Cur: memBytes, Max: memBytes}
+		if err := syscall.Setrlimit(syscall.RLIMIT_AS, &limit); err != nil {
+			return fmt.Errorf("%s: setrlimit AS: %w", ExecHelperArg, err)
+		}
+	}
+
+	binary := args[2]
+	binPath, err := exec.LookPath(binary)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ExecHelperArg, err)
+	}
+	return syscall.Exec(binPath, args[2:], os.Environ())
+}
@@ -0,0 +1,28 @@
+//go:build unix
+
+package runner
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestRunCPULimit exercises startWithLimits' self-reexec-as-helper
+// path end-to-end: a prior version lowered the judge process's own
+// rlimits instead, which only needed a concurrent goroutine/thread to
+// be racy, so this is worth pinning down with a real process.
+func TestRunCPULimit(t *testing.T) {
+	requireTool(t, "sh")
+
+	res := Run(context.Background(), "/bin/sh", []string{"-c", "i=0; while true; do i=$((i+1)); done"},
+		strings.NewReader(""), Limits{Wall: 5 * time.Second, CPU: 1 * time.Second})
+
+	if res.Verdict != TLE {
+		t.Fatalf("Verdict = %s, want TLE (err: %v)", res.Verdict, res.Err)
+	}
+	if res.Err == nil || !strings.Contains(res.Err.Error(), "cpu time limit") {
+		t.Errorf("Err = %v, want it to mention the cpu time limit", res.Err)
+	}
+}
@@ -0,0 +1,167 @@
+// Package runner executes a submission binary against a single test
+// case under wall-clock, CPU, and memory limits, streaming its stdout
+// and stderr without deadlocking on large output.
+package runner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"syscall"
+	"time"
+)
+
+// ExecHelperArg is a hidden first CLI argument: when main sees it, it
+// must dispatch to RunExecHelper instead of normal command parsing.
+// startWithLimits re-execs the judge binary itself with this argument
+// to apply CPU/memory rlimits to a submission without ever touching
+// the judge process's own limits; see rlimit_unix.go.
+const ExecHelperArg = "__judge-internal-exec-with-limits"
+
+// Verdict is the outcome of running a submission against a single test
+// case's input, before the output has been checked against an expected
+// answer.
+type Verdict string
+
+const (
+	AC  Verdict = "AC"  // exited cleanly within the limits
+	RE  Verdict = "RE"  // exited with a non-zero status or failed to start
+	TLE Verdict = "TLE" // did not finish within the wall-clock limit
+)
+
+// Limits bounds the resources a submission may consume while running a
+// single test case. A zero value in any field means "unlimited".
+type Limits struct {
+	Wall           time.Duration // enforced via context.WithTimeout
+	CPU            time.Duration // enforced via RLIMIT_CPU (unix only)
+	MemoryBytes    int64         // enforced via RLIMIT_AS (unix only)
+	MaxOutputBytes int64         // captured stdout/stderr is truncated beyond this
+}
+
+// Result is the outcome of running a submission against one test case.
+// Run does not itself decide AC vs WA: comparing Stdout against the
+// expected answer is the checker package's job.
+type Result struct {
+	Verdict  Verdict
+	Stdout   []byte
+	Stderr   []byte
+	Err      error
+	Wall     time.Duration
+	CPU      time.Duration
+	MaxRSSKB int64
+	ExitCode int
+}
+
+// Run starts submission with stdin piped from in, waits for it to
+// finish or hit a limit, and returns the captured output and resource
+// usage.
+func Run(ctx context.Context, submission string, args []string, stdin io.Reader, limits Limits) Result {
+	if limits.Wall > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, limits.Wall)
+		defer cancel()
+	}
+
+	cmd := exec.Command(submission, args...)
+	cmd.Stdin = stdin
+
+	stdout := boundedBuffer{max: limits.MaxOutputBytes}
+	stderr := boundedBuffer{max: limits.MaxOutputBytes}
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	start := time.Now()
+	if err := startWithLimits(cmd, limits); err != nil {
+		return Result{Verdict: RE, Err: err}
+	}
+
+	waitDone := make(chan error, 1)
+	go func() { waitDone <- cmd.Wait() }()
+
+	var waitErr error
+	select {
+	case waitErr = <-waitDone:
+	case <-ctx.Done():
+		cmd.Process.Kill()
+		waitErr = <-waitDone
+	}
+
+	res := Result{
+		Stdout: stdout.buf.Bytes(),
+		Stderr: stderr.buf.Bytes(),
+		Wall:   time.Since(start),
+	}
+
+	if ctx.Err() == context.DeadlineExceeded {
+		res.Verdict = TLE
+		res.Err = ctx.Err()
+		return res
+	}
+
+	if state := cmd.ProcessState; state != nil {
+		res.ExitCode = state.ExitCode()
+		if rusage, ok := state.SysUsage().(*syscall.Rusage); ok {
+			res.CPU = time.Duration(rusage.Utime.Nano() + rusage.Stime.Nano())
+			res.MaxRSSKB = rusage.Maxrss
+		}
+	}
+
+	if waitErr != nil {
+		if limits.CPU > 0 && killedByCPULimit(waitErr) {
+			res.Verdict = TLE
+			res.Err = fmt.Errorf("cpu time limit (%s) exceeded", limits.CPU)
+			return res
+		}
+		res.Verdict = RE
+		res.Err = waitErr
+		return res
+	}
+
+	res.Verdict = AC
+	return res
+}
+
+// killedByCPULimit reports whether waitErr came from a process killed
+// by SIGXCPU or SIGKILL — the signals the kernel sends when RLIMIT_CPU
+// is exceeded (SIGXCPU first, then SIGKILL if the process ignores it).
+// Without this, a submission that's merely slow looks identical to one
+// that crashed.
+func killedByCPULimit(waitErr error) bool {
+	exitErr, ok := waitErr.(*exec.ExitError)
+	if !ok {
+		return false
+	}
+	status, ok := exitErr.Sys().(syscall.WaitStatus)
+	if !ok || !status.Signaled() {
+		return false
+	}
+	return status.Signal() == syscall.SIGXCPU || status.Signal() == syscall.SIGKILL
+}
+
+// boundedBuffer is an io.Writer that keeps only the first max bytes
+// written to it, discarding the rest, so a submission writing
+// gigabytes of output can't exhaust memory. cmd.Stdout/Stderr are set
+// directly to a boundedBuffer rather than read via cmd.StdoutPipe, so
+// os/exec's own copying goroutines (which Wait already synchronizes
+// with) do the draining instead of a hand-rolled one racing Wait's
+// pipe teardown.
+type boundedBuffer struct {
+	buf bytes.Buffer
+	max int64
+}
+
+func (b *boundedBuffer) Write(p []byte) (int, error) {
+	if b.max > 0 {
+		if room := b.max - int64(b.buf.Len()); room < int64(len(p)) {
+			if room < 0 {
+				room = 0
+			}
+			b.buf.Write(p[:room])
+			return len(p), nil
+		}
+	}
+	b.buf.Write(p)
+	return len(p), nil
+}
@@ -0,0 +1,49 @@
+package runner
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+func requireTool(t *testing.T, name string) {
+	t.Helper()
+	if _, err := exec.LookPath(name); err != nil {
+		t.Skipf("%s not installed", name)
+	}
+}
+
+func TestRunAC(t *testing.T) {
+	requireTool(t, "echo")
+
+	res := Run(context.Background(), "/bin/echo", []string{"hello"}, strings.NewReader(""), Limits{})
+	if res.Verdict != AC {
+		t.Fatalf("Verdict = %s, want AC (err: %v)", res.Verdict, res.Err)
+	}
+	if got := strings.TrimSpace(string(res.Stdout)); got != "hello" {
+		t.Errorf("Stdout = %q, want %q", got, "hello")
+	}
+}
+
+func TestRunNonZeroExit(t *testing.T) {
+	requireTool(t, "sh")
+
+	res := Run(context.Background(), "/bin/sh", []string{"-c", "exit 3"}, strings.NewReader(""), Limits{})
+	if res.Verdict != RE {
+		t.Fatalf("Verdict = %s, want RE (err: %v)", res.Verdict, res.Err)
+	}
+	if res.ExitCode != 3 {
+		t.Errorf("ExitCode = %d, want 3", res.ExitCode)
+	}
+}
+
+func TestRunWallTimeout(t *testing.T) {
+	requireTool(t, "sleep")
+
+	res := Run(context.Background(), "/bin/sleep", []string{"5"}, strings.NewReader(""), Limits{Wall: 50 * time.Millisecond})
+	if res.Verdict != TLE {
+		t.Fatalf("Verdict = %s, want TLE (err: %v)", res.Verdict, res.Err)
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import "time"
+
+// Verdict is the outcome of running a submission against a single test
+// case.
+type Verdict string
+
+const (
+	AC  Verdict = "AC"  // output matched the expected answer
+	WA  Verdict = "WA"  // output did not match the expected answer
+	RE  Verdict = "RE"  // the submission exited with a runtime error
+	TLE Verdict = "TLE" // the submission did not finish in time
+	CE  Verdict = "CE"  // the submission failed to compile
+)
+
+// CaseResult is the verdict for one test case, plus whatever detail is
+// useful for a human deciding why a case failed and enough resource
+// usage to log to the journal.
+type CaseResult struct {
+	Name     string
+	Verdict  Verdict
+	Detail   string
+	Wall     time.Duration
+	CPU      time.Duration
+	MaxRSSKB int64
+	ExitCode int
+	Stdout   string
+	Stderr   string
+}